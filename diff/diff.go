@@ -0,0 +1,101 @@
+// Package diff builds set-difference style edit scripts on top of the
+// collections package's Zipper primitive.
+package diff
+
+import (
+	"fmt"
+
+	"github.com/golang-collections/collections-1"
+)
+
+// DiffOp identifies the kind of edit a diff step represents.
+type DiffOp int
+
+const (
+	// Keep indicates the element is present, unchanged, in both collections.
+	Keep DiffOp = iota
+	// Insert indicates the element is only present in the right collection.
+	Insert
+	// Delete indicates the element is only present in the left collection.
+	Delete
+)
+
+// DiffResult is a structured edit script between two sorted collections,
+// gathered by Diff. LeftOnly and RightOnly hold the indices (into the left
+// and right collections respectively) present on only one side; Common holds
+// the left indices present on both sides.
+type DiffResult struct {
+	LeftOnly  []int
+	RightOnly []int
+	Common    []int
+}
+
+type diffZipper struct {
+	collections.Zipper
+	result DiffResult
+}
+
+func (d *diffZipper) AddLeft(i int)    { d.result.LeftOnly = append(d.result.LeftOnly, i) }
+func (d *diffZipper) AddRight(j int)   { d.result.RightOnly = append(d.result.RightOnly, j) }
+func (d *diffZipper) AddBoth(i, j int) { d.result.Common = append(d.result.Common, i) }
+
+// Diff computes the full edit script between the two sorted collections
+// underlying z.
+func Diff(z collections.Zipper) DiffResult {
+	d := &diffZipper{Zipper: z}
+	collections.ZipWithGaps(d)
+	return d.result
+}
+
+type streamZipper struct {
+	collections.Zipper
+	emit func(op DiffOp, i, j int)
+}
+
+func (s *streamZipper) AddLeft(i int)    { s.emit(Delete, i, -1) }
+func (s *streamZipper) AddRight(j int)   { s.emit(Insert, -1, j) }
+func (s *streamZipper) AddBoth(i, j int) { s.emit(Keep, i, j) }
+
+// DiffStream computes the same edit script as Diff, but calls emit as each
+// step is produced instead of collecting it into a DiffResult.
+func DiffStream(z collections.Zipper, emit func(op DiffOp, i, j int)) {
+	collections.ZipWithGaps(&streamZipper{Zipper: z, emit: emit})
+}
+
+// Patch applies an edit script produced by DiffStream back onto base,
+// driving base's AddLeft/AddRight/AddBoth for each Delete/Insert/Keep op in
+// turn. It returns an error if ops references an index out of range for
+// base.
+func Patch(base collections.Zipper, ops []DiffOp) error {
+	i, j := 0, 0
+	maxLeft, maxRight := base.LenLeft(), base.LenRight()
+	for _, op := range ops {
+		switch op {
+		case Delete:
+			if i >= maxLeft {
+				return fmt.Errorf("diff: Patch: Delete at left index %d out "+
+					"of range (len %d)", i, maxLeft)
+			}
+			base.AddLeft(i)
+			i++
+		case Insert:
+			if j >= maxRight {
+				return fmt.Errorf("diff: Patch: Insert at right index %d "+
+					"out of range (len %d)", j, maxRight)
+			}
+			base.AddRight(j)
+			j++
+		case Keep:
+			if i >= maxLeft || j >= maxRight {
+				return fmt.Errorf("diff: Patch: Keep at (%d, %d) out of "+
+					"range (lens %d, %d)", i, j, maxLeft, maxRight)
+			}
+			base.AddBoth(i, j)
+			i++
+			j++
+		default:
+			return fmt.Errorf("diff: Patch: unknown op %d", op)
+		}
+	}
+	return nil
+}
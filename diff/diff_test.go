@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang-collections/collections-1"
+)
+
+// intZipper zips together two sorted []int slices, recording each
+// AddLeft/AddRight/AddBoth call as a (op, i, j) triple.
+type intZipper struct {
+	left, right []int
+	ops         [][3]int
+}
+
+func (z *intZipper) LenLeft() int  { return len(z.left) }
+func (z *intZipper) LenRight() int { return len(z.right) }
+
+func (z *intZipper) Compare(i, j int) collections.Ord {
+	a, b := z.left[i], z.right[j]
+	switch {
+	case a < b:
+		return collections.Less
+	case a > b:
+		return collections.Greater
+	default:
+		return collections.Equal
+	}
+}
+
+func (z *intZipper) AddLeft(i int)    { z.ops = append(z.ops, [3]int{int(Delete), i, -1}) }
+func (z *intZipper) AddRight(j int)   { z.ops = append(z.ops, [3]int{int(Insert), -1, j}) }
+func (z *intZipper) AddBoth(i, j int) { z.ops = append(z.ops, [3]int{int(Keep), i, j}) }
+
+func TestDiff(t *testing.T) {
+	left := []int{1, 3, 5, 5, 9}
+	right := []int{2, 3, 5, 8}
+
+	result := Diff(&intZipper{left: left, right: right})
+
+	wantLeftOnly := []int{0, 3, 4}
+	wantRightOnly := []int{0, 3}
+	wantCommon := []int{1, 2}
+
+	if !reflect.DeepEqual(result.LeftOnly, wantLeftOnly) {
+		t.Errorf("LeftOnly = %v, want %v", result.LeftOnly, wantLeftOnly)
+	}
+	if !reflect.DeepEqual(result.RightOnly, wantRightOnly) {
+		t.Errorf("RightOnly = %v, want %v", result.RightOnly, wantRightOnly)
+	}
+	if !reflect.DeepEqual(result.Common, wantCommon) {
+		t.Errorf("Common = %v, want %v", result.Common, wantCommon)
+	}
+}
+
+func TestDiffStreamMatchesDiff(t *testing.T) {
+	left := []int{1, 3, 5, 5, 9}
+	right := []int{2, 3, 5, 8}
+
+	result := Diff(&intZipper{left: left, right: right})
+
+	var leftOnly, rightOnly, common []int
+	DiffStream(&intZipper{left: left, right: right}, func(op DiffOp, i, j int) {
+		switch op {
+		case Delete:
+			leftOnly = append(leftOnly, i)
+		case Insert:
+			rightOnly = append(rightOnly, j)
+		case Keep:
+			common = append(common, i)
+		}
+	})
+
+	if !reflect.DeepEqual(leftOnly, result.LeftOnly) {
+		t.Errorf("DiffStream LeftOnly = %v, want %v", leftOnly, result.LeftOnly)
+	}
+	if !reflect.DeepEqual(rightOnly, result.RightOnly) {
+		t.Errorf("DiffStream RightOnly = %v, want %v", rightOnly, result.RightOnly)
+	}
+	if !reflect.DeepEqual(common, result.Common) {
+		t.Errorf("DiffStream Common = %v, want %v", common, result.Common)
+	}
+}
+
+func TestPatchRoundTrips(t *testing.T) {
+	left := []int{1, 3, 5, 5, 9}
+	right := []int{2, 3, 5, 8}
+
+	want := &intZipper{left: left, right: right}
+	collections.ZipWithGaps(want)
+
+	var ops []DiffOp
+	DiffStream(&intZipper{left: left, right: right}, func(op DiffOp, i, j int) {
+		ops = append(ops, op)
+	})
+
+	got := &intZipper{left: left, right: right}
+	if err := Patch(got, ops); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.ops, want.ops) {
+		t.Errorf("Patch replay = %v, want %v", got.ops, want.ops)
+	}
+}
+
+func TestPatchOutOfRange(t *testing.T) {
+	z := &intZipper{left: []int{1}, right: []int{1}}
+	if err := Patch(z, []DiffOp{Keep, Keep}); err == nil {
+		t.Error("Patch: expected error for out-of-range op, got nil")
+	}
+}
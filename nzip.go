@@ -0,0 +1,118 @@
+package collections
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// NZipper generalizes Zipper to an arbitrary number of sorted collections.
+// Elements of each collection are assumed to be sorted in ascending order.
+type NZipper interface {
+	// NumCollections returns the number of collections being zipped.
+	NumCollections() int
+	// Len returns the length of the k'th collection.
+	Len(k int) int
+	// Compare compares the element at i1 in collection k1 against the element
+	// at i2 in collection k2, returning the comparison with respect to the
+	// k1 value. If that element is less than the k2 element, Compare should
+	// return Less. If the elements are equal, return Equal. If the k1
+	// element is greater, return Greater. Any other value will cause a panic
+	// during ZipNWithGaps.
+	Compare(k1, i1, k2, i2 int) Ord
+	// Add is called once per merge step with the current position of each
+	// collection. indices[k] is the index that collection k contributed at
+	// this step, or -1 if collection k did not contribute.
+	Add(indices []int)
+}
+
+// nzipHeapEntry is a single (collection, position) pair tracked by the
+// min-heap used by ZipNWithGaps.
+type nzipHeapEntry struct {
+	collection int
+	pos        int
+}
+
+// nzipHeap orders entries by comparing the head elements of their
+// collections via z.Compare.
+type nzipHeap struct {
+	z       NZipper
+	entries []nzipHeapEntry
+}
+
+func (h *nzipHeap) Len() int { return len(h.entries) }
+
+func (h *nzipHeap) Less(a, b int) bool {
+	ea, eb := h.entries[a], h.entries[b]
+	c := h.z.Compare(ea.collection, ea.pos, eb.collection, eb.pos)
+	switch c {
+	case Less:
+		return true
+	case Equal, Greater:
+		return false
+	default:
+		panic(fmt.Sprintf("ZipNWithGaps: compare returned %d: expected %s, "+
+			"%s, or %s", c, Less, Equal, Greater))
+	}
+}
+
+func (h *nzipHeap) Swap(a, b int) {
+	h.entries[a], h.entries[b] = h.entries[b], h.entries[a]
+}
+
+func (h *nzipHeap) Push(x any) {
+	h.entries = append(h.entries, x.(nzipHeapEntry))
+}
+
+func (h *nzipHeap) Pop() any {
+	n := len(h.entries)
+	e := h.entries[n-1]
+	h.entries = h.entries[:n-1]
+	return e
+}
+
+// ZipNWithGaps generalizes ZipWithGaps to K sorted input collections. At each
+// step, the least head element across all collections is found along with
+// every other collection whose head element compares Equal to it; z.Add is
+// called with the contributing positions filled in and -1 everywhere else.
+// Each contributing cursor is then advanced. Assumes every collection is
+// sorted in ascending order when ordered by z.Compare.
+//
+// This runs in O(N log K) time for a total of N elements across K
+// collections, against the min-heap of per-collection head positions.
+func ZipNWithGaps(z NZipper) {
+	k := z.NumCollections()
+	h := &nzipHeap{z: z}
+	for c := 0; c < k; c++ {
+		if n := z.Len(c); n < 0 {
+			panic(fmt.Sprintf("ZipNWithGaps: negative length %d for "+
+				"collection %d", n, c))
+		} else if n > 0 {
+			heap.Push(h, nzipHeapEntry{collection: c, pos: 0})
+		}
+	}
+
+	for h.Len() > 0 {
+		least := h.entries[0]
+		matched := []nzipHeapEntry{heap.Pop(h).(nzipHeapEntry)}
+		for h.Len() > 0 && z.Compare(least.collection, least.pos,
+			h.entries[0].collection, h.entries[0].pos) == Equal {
+			matched = append(matched, heap.Pop(h).(nzipHeapEntry))
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = -1
+		}
+		for _, e := range matched {
+			indices[e.collection] = e.pos
+		}
+		z.Add(indices)
+
+		for _, e := range matched {
+			next := e.pos + 1
+			if next < z.Len(e.collection) {
+				heap.Push(h, nzipHeapEntry{collection: e.collection, pos: next})
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// naiveZipN is a brute-force, non-heap reference implementation of
+// ZipNWithGaps: at every step it scans every collection's current head
+// (O(K) per step instead of ZipNWithGaps's O(log K)) to find the minimum and
+// its ties. It exists purely so ZipNWithGaps's heap-based merge can be
+// checked against an independently written implementation of the same
+// contract.
+func naiveZipN(numCols int, lens []int, compare func(k1, i1, k2, i2 int) Ord) [][]int {
+	pos := make([]int, numCols)
+	var steps [][]int
+	for {
+		least := -1
+		for k := 0; k < numCols; k++ {
+			if pos[k] >= lens[k] {
+				continue
+			}
+			if least == -1 || compare(k, pos[k], least, pos[least]) == Less {
+				least = k
+			}
+		}
+		if least == -1 {
+			return steps
+		}
+
+		indices := make([]int, numCols)
+		for k := range indices {
+			indices[k] = -1
+		}
+		indices[least] = pos[least]
+		for k := 0; k < numCols; k++ {
+			if k == least || pos[k] >= lens[k] {
+				continue
+			}
+			if compare(k, pos[k], least, pos[least]) == Equal {
+				indices[k] = pos[k]
+			}
+		}
+
+		steps = append(steps, indices)
+		for k, idx := range indices {
+			if idx != -1 {
+				pos[k]++
+			}
+		}
+	}
+}
+
+// intNZipper zips together sorted []int collections, recording the indices
+// passed to each Add call.
+type intNZipper struct {
+	cols  [][]int
+	added [][]int
+}
+
+func (z *intNZipper) NumCollections() int { return len(z.cols) }
+func (z *intNZipper) Len(k int) int       { return len(z.cols[k]) }
+
+func (z *intNZipper) Compare(k1, i1, k2, i2 int) Ord {
+	a, b := z.cols[k1][i1], z.cols[k2][i2]
+	switch {
+	case a < b:
+		return Less
+	case a > b:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+func (z *intNZipper) Add(indices []int) {
+	cp := append([]int(nil), indices...)
+	z.added = append(z.added, cp)
+}
+
+func TestZipNWithGaps(t *testing.T) {
+	cases := []struct {
+		name string
+		cols [][]int
+	}{
+		{"empty", [][]int{{}, {}, {}}},
+		{"single collection", [][]int{{1, 2, 3}}},
+		{"no ties", [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}},
+		{"all tied", [][]int{{5, 5}, {5, 5}, {5, 5}}},
+		{"partial ties", [][]int{{1, 5, 5, 9}, {5, 5, 8}, {0, 5, 10}}},
+		{"one collection empty", [][]int{{1, 2}, {}, {3, 4}}},
+		{"duplicate within a collection", [][]int{{2, 2, 4}, {2, 3}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lens := make([]int, len(tc.cols))
+			for k, c := range tc.cols {
+				lens[k] = len(c)
+			}
+
+			z := &intNZipper{cols: tc.cols}
+			want := naiveZipN(len(tc.cols), lens, z.Compare)
+
+			ZipNWithGaps(z)
+
+			if !reflect.DeepEqual(z.added, want) {
+				t.Errorf("ZipNWithGaps(%v) = %v, want %v", tc.cols, z.added, want)
+			}
+		})
+	}
+}
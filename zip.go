@@ -2,6 +2,40 @@ package collections
 
 import "fmt"
 
+// Ord represents the result of comparing one value against another.
+type Ord int
+
+const (
+	// Less indicates the compared value is less than the other.
+	Less Ord = iota - 1
+	// Equal indicates the compared value is equal to the other.
+	Equal
+	// Greater indicates the compared value is greater than the other.
+	Greater
+)
+
+func (o Ord) String() string {
+	switch o {
+	case Less:
+		return "Less"
+	case Equal:
+		return "Equal"
+	case Greater:
+		return "Greater"
+	default:
+		return fmt.Sprintf("Ord(%d)", int(o))
+	}
+}
+
+// Comparable is implemented by types that can compare the elements at two
+// indices, such as the two collections underlying a Zipper.
+type Comparable interface {
+	// Compare compares the elements at i and j, returning Less if the
+	// element at i is less than the element at j, Equal if they are equal,
+	// or Greater if the element at i is greater.
+	Compare(i, j int) Ord
+}
+
 // Zipper represents a pair of ordered collections that can be zipped together.
 // Elements of each collection are assumed to be sorted in ascending order.
 type Zipper interface {
@@ -0,0 +1,109 @@
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// intOp records a single AddLeft/AddRight/AddBoth call against the original,
+// unsharded indices, so results from different shard counts can be compared
+// directly against a plain ZipWithGaps run.
+type intOp struct {
+	side Side
+	i, j int
+}
+
+// intParallelZipper zips together two sorted []int slices and implements
+// ParallelZipper so ZipWithGapsParallel can be checked against plain
+// ZipWithGaps over the same data.
+type intParallelZipper struct {
+	left, right       []int
+	leftOff, rightOff int
+	ops               *[]intOp
+}
+
+func newIntParallelZipper(left, right []int) *intParallelZipper {
+	return &intParallelZipper{left: left, right: right, ops: &[]intOp{}}
+}
+
+func (z *intParallelZipper) LenLeft() int  { return len(z.left) }
+func (z *intParallelZipper) LenRight() int { return len(z.right) }
+
+func (z *intParallelZipper) Compare(i, j int) Ord {
+	a, b := z.left[i], z.right[j]
+	switch {
+	case a < b:
+		return Less
+	case a > b:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+func (z *intParallelZipper) AddLeft(i int) {
+	*z.ops = append(*z.ops, intOp{Left, z.leftOff + i, -1})
+}
+
+func (z *intParallelZipper) AddRight(j int) {
+	*z.ops = append(*z.ops, intOp{Right, -1, z.rightOff + j})
+}
+
+func (z *intParallelZipper) AddBoth(i, j int) {
+	*z.ops = append(*z.ops, intOp{Both, z.leftOff + i, z.rightOff + j})
+}
+
+func (z *intParallelZipper) SplitPoint(leftIdx int) int {
+	v := z.left[leftIdx]
+	return sort.Search(len(z.right), func(j int) bool { return z.right[j] >= v })
+}
+
+func (z *intParallelZipper) Shard(leftLo, leftHi, rightLo, rightHi int) Zipper {
+	shard := newIntParallelZipper(z.left[leftLo:leftHi], z.right[rightLo:rightHi])
+	shard.leftOff, shard.rightOff = leftLo, rightLo
+	return shard
+}
+
+func (z *intParallelZipper) Result() any { return *z.ops }
+
+func (z *intParallelZipper) Merge(shardResults []any) {
+	merged := make([]intOp, 0, len(*z.ops))
+	for _, r := range shardResults {
+		merged = append(merged, r.([]intOp)...)
+	}
+	*z.ops = merged
+}
+
+func TestZipWithGapsParallel(t *testing.T) {
+	cases := []struct {
+		name  string
+		left  []int
+		right []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"no ties", []int{1, 4, 7, 10}, []int{2, 5, 8, 11}},
+		{"fully tied", []int{5, 5}, []int{5, 5}},
+		{"tie straddling an even split", []int{1, 5, 5, 5, 9}, []int{5, 5, 8}},
+		{"long tied run", []int{1, 3, 3, 3, 3, 3, 3, 9}, []int{0, 3, 3, 3, 10}},
+		{"left longer", []int{1, 2, 3, 4, 5, 6, 7, 8}, []int{4}},
+		{"right longer", []int{4}, []int{1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := newIntParallelZipper(tc.left, tc.right)
+			ZipWithGaps(want)
+
+			for _, shards := range []int{1, 2, 3, 5} {
+				got := newIntParallelZipper(tc.left, tc.right)
+				ZipWithGapsParallel(got, shards)
+
+				if !reflect.DeepEqual(*got.ops, *want.ops) {
+					t.Errorf("shards=%d: ZipWithGapsParallel(%v, %v) = %v, want %v",
+						shards, tc.left, tc.right, *got.ops, *want.ops)
+				}
+			}
+		})
+	}
+}
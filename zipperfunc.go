@@ -0,0 +1,73 @@
+package collections
+
+import (
+	"strings"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// ZipperFunc adapts a set of plain functions into a Zipper, the way
+// http.HandlerFunc adapts a function into a http.Handler. It saves callers
+// from hand-rolling a Zipper struct for every merge.
+type ZipperFunc struct {
+	CompareFunc  func(i, j int) Ord
+	LenLeftFunc  func() int
+	LenRightFunc func() int
+	AddLeftFunc  func(i int)
+	AddRightFunc func(j int)
+	AddBothFunc  func(i, j int)
+}
+
+func (z *ZipperFunc) Compare(i, j int) Ord { return z.CompareFunc(i, j) }
+func (z *ZipperFunc) LenLeft() int         { return z.LenLeftFunc() }
+func (z *ZipperFunc) LenRight() int        { return z.LenRightFunc() }
+func (z *ZipperFunc) AddLeft(i int)        { z.AddLeftFunc(i) }
+func (z *ZipperFunc) AddRight(j int)       { z.AddRightFunc(j) }
+func (z *ZipperFunc) AddBoth(i, j int)     { z.AddBothFunc(i, j) }
+
+// ordFromCompare converts the result of a cmp.Compare-style function (negative
+// if a < b, zero if equal, positive if a > b) into an Ord.
+func ordFromCompare(c int) Ord {
+	switch {
+	case c < 0:
+		return Less
+	case c > 0:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// ZipSlices zips two sorted slices without requiring callers to define a
+// Zipper. less must order left and right consistently with each other,
+// following the same negative/zero/positive convention as cmp.Compare; pass a
+// different less to zip the same slices under a different ordering, such as
+// descending or case-insensitive. onLeft, onRight, and onBoth receive the
+// corresponding elements and play the role of AddLeft, AddRight, and AddBoth.
+func ZipSlices[T any](left, right []T, less func(a, b T) int, onLeft,
+	onRight func(v T), onBoth func(l, r T)) {
+	ZipWithGaps(&ZipperFunc{
+		CompareFunc:  func(i, j int) Ord { return ordFromCompare(less(left[i], right[j])) },
+		LenLeftFunc:  func() int { return len(left) },
+		LenRightFunc: func() int { return len(right) },
+		AddLeftFunc:  func(i int) { onLeft(left[i]) },
+		AddRightFunc: func(j int) { onRight(right[j]) },
+		AddBothFunc:  func(i, j int) { onBoth(left[i], right[j]) },
+	})
+}
+
+// CompareStringsCI compares a and b case-insensitively, following the
+// negative/zero/positive convention expected by ZipSlices' less parameter.
+func CompareStringsCI(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// CompareStringsLocale compares a and b according to the collation rules of
+// loc, following the negative/zero/positive convention expected by
+// ZipSlices' less parameter. Collections zipped with it must already be
+// sorted under the same locale's collation order.
+func CompareStringsLocale(loc language.Tag) func(a, b string) int {
+	c := collate.New(loc)
+	return c.CompareString
+}
@@ -0,0 +1,110 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func cmpInt(a, b int) int { return a - b }
+
+func TestZipSlices(t *testing.T) {
+	cases := []struct {
+		name        string
+		left, right []int
+		want        [][3]int // (side, leftVal, rightVal); -1 for the side not contributing
+	}{
+		{
+			name:  "no ties",
+			left:  []int{1, 4, 7},
+			right: []int{2, 5, 8},
+			want: [][3]int{
+				{int(Left), 1, -1}, {int(Right), -1, 2}, {int(Left), 4, -1},
+				{int(Right), -1, 5}, {int(Left), 7, -1}, {int(Right), -1, 8},
+			},
+		},
+		{
+			name:  "ties",
+			left:  []int{1, 5, 5, 9},
+			right: []int{5, 5, 8},
+			want: [][3]int{
+				{int(Left), 1, -1}, {int(Both), 5, 5}, {int(Both), 5, 5},
+				{int(Right), -1, 8}, {int(Left), 9, -1},
+			},
+		},
+		{
+			name:  "left only",
+			left:  []int{1, 2, 3},
+			right: []int{},
+			want: [][3]int{
+				{int(Left), 1, -1}, {int(Left), 2, -1}, {int(Left), 3, -1},
+			},
+		},
+		{
+			name:  "right only",
+			left:  []int{},
+			right: []int{1, 2, 3},
+			want: [][3]int{
+				{int(Right), -1, 1}, {int(Right), -1, 2}, {int(Right), -1, 3},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got [][3]int
+			ZipSlices(tc.left, tc.right, cmpInt,
+				func(v int) { got = append(got, [3]int{int(Left), v, -1}) },
+				func(v int) { got = append(got, [3]int{int(Right), -1, v}) },
+				func(l, r int) { got = append(got, [3]int{int(Both), l, r}) })
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ZipSlices(%v, %v) = %v, want %v", tc.left, tc.right, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompareStringsCI(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"apple", "APPLE", 0},
+		{"Apple", "banana", -1},
+		{"Banana", "apple", 1},
+	}
+
+	for _, tc := range cases {
+		if got := CompareStringsCI(tc.a, tc.b); sign(got) != tc.want {
+			t.Errorf("CompareStringsCI(%q, %q) = %d, want sign %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCompareStringsLocale(t *testing.T) {
+	// Swedish treats "ä" as a distinct letter sorting after "z"; German
+	// collates it near "a". The same pair of strings should therefore
+	// compare oppositely under the two locales.
+	sv := CompareStringsLocale(language.Swedish)
+	de := CompareStringsLocale(language.German)
+
+	if got := sign(sv("z", "ä")); got != -1 {
+		t.Errorf("CompareStringsLocale(Swedish)(\"z\", \"ä\") = %d, want -1", got)
+	}
+	if got := sign(de("z", "ä")); got != 1 {
+		t.Errorf("CompareStringsLocale(German)(\"z\", \"ä\") = %d, want 1", got)
+	}
+}
+
+func sign(c int) int {
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return 1
+	default:
+		return 0
+	}
+}
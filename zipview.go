@@ -0,0 +1,90 @@
+package collections
+
+import "fmt"
+
+// Side indicates which collection(s) contributed to a step of a ZipView.
+type Side int
+
+const (
+	// Left indicates only the left collection contributed at this step.
+	Left Side = iota
+	// Right indicates only the right collection contributed at this step.
+	Right
+	// Both indicates both collections contributed at this step.
+	Both
+)
+
+// ZipView is a pull-based cursor over a zipped pair of sorted collections.
+// Unlike ZipWithGaps, which pushes results through AddLeft/AddRight/AddBoth
+// callbacks until the zip is exhausted, ZipView lets the caller advance one
+// step at a time, so a zip can be broken out of early, fed into a channel, or
+// composed with combinators such as Map or Filter.
+type ZipView interface {
+	// Next advances the view by one step, returning which side(s)
+	// contributed and their indices. i or j is -1 when side is Right or
+	// Left respectively. ok is false once the zip is exhausted, at which
+	// point side, i, and j are undefined.
+	Next() (side Side, i, j int, ok bool)
+	// Reset rewinds the view back to its initial position.
+	Reset()
+}
+
+// zipView implements ZipView over a Zipper's Compare/LenLeft/LenRight. Its
+// AddLeft/AddRight/AddBoth methods go unused: Next reports side, i, and j to
+// the caller directly instead of driving those callbacks.
+type zipView struct {
+	z          Zipper
+	i, j       int
+	maxI, maxJ int
+}
+
+// NewZipView returns a lazy, pull-based view over z. It implements the same
+// gap-aware merge as ZipWithGaps, but exposes it as a Next-able cursor
+// instead of driving z's AddLeft/AddRight/AddBoth callbacks to completion.
+func NewZipView(z Zipper) ZipView {
+	v := &zipView{z: z}
+	v.Reset()
+	return v
+}
+
+func (v *zipView) Reset() {
+	v.i, v.j = 0, 0
+	v.maxI, v.maxJ = v.z.LenLeft(), v.z.LenRight()
+	if v.maxI < 0 || v.maxJ < 0 {
+		panic(fmt.Sprintf("NewZipView: negative lengths %d %d", v.maxI, v.maxJ))
+	}
+}
+
+func (v *zipView) Next() (side Side, i, j int, ok bool) {
+	switch {
+	case v.i >= v.maxI && v.j >= v.maxJ:
+		return 0, -1, -1, false
+	case v.i >= v.maxI:
+		j := v.j
+		v.j++
+		return Right, -1, j, true
+	case v.j >= v.maxJ:
+		i := v.i
+		v.i++
+		return Left, i, -1, true
+	default:
+		switch c := v.z.Compare(v.i, v.j); {
+		case c == Less:
+			i := v.i
+			v.i++
+			return Left, i, -1, true
+		case c == Greater:
+			j := v.j
+			v.j++
+			return Right, -1, j, true
+		case c == Equal:
+			i, j := v.i, v.j
+			v.i++
+			v.j++
+			return Both, i, j, true
+		default:
+			panic(fmt.Sprintf("ZipView.Next: compare returned %d: expected "+
+				"%s, %s, or %s", c, Less, Equal, Greater))
+		}
+	}
+}
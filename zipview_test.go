@@ -0,0 +1,93 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// intZipper zips together two sorted []int slices, recording each
+// AddLeft/AddRight/AddBoth call as a (Side, i, j) triple.
+type intZipper struct {
+	left, right []int
+	steps       [][3]int
+}
+
+func (z *intZipper) LenLeft() int  { return len(z.left) }
+func (z *intZipper) LenRight() int { return len(z.right) }
+
+func (z *intZipper) Compare(i, j int) Ord {
+	a, b := z.left[i], z.right[j]
+	switch {
+	case a < b:
+		return Less
+	case a > b:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+func (z *intZipper) AddLeft(i int)    { z.steps = append(z.steps, [3]int{int(Left), i, -1}) }
+func (z *intZipper) AddRight(j int)   { z.steps = append(z.steps, [3]int{int(Right), -1, j}) }
+func (z *intZipper) AddBoth(i, j int) { z.steps = append(z.steps, [3]int{int(Both), i, j}) }
+
+func TestZipView(t *testing.T) {
+	cases := []struct {
+		name        string
+		left, right []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"no ties", []int{1, 4, 7}, []int{2, 5, 8}},
+		{"ties", []int{1, 5, 5, 9}, []int{5, 5, 8}},
+		{"left only", []int{1, 2, 3}, []int{}},
+		{"right only", []int{}, []int{1, 2, 3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			z := &intZipper{left: tc.left, right: tc.right}
+			ZipWithGaps(z)
+
+			var got [][3]int
+			v := NewZipView(&intZipper{left: tc.left, right: tc.right})
+			for {
+				side, i, j, ok := v.Next()
+				if !ok {
+					break
+				}
+				got = append(got, [3]int{int(side), i, j})
+			}
+
+			if !reflect.DeepEqual(got, z.steps) {
+				t.Errorf("ZipView.Next() steps = %v, want %v", got, z.steps)
+			}
+		})
+	}
+}
+
+func TestZipViewReset(t *testing.T) {
+	v := NewZipView(&intZipper{left: []int{1, 2}, right: []int{1, 3}})
+
+	var first [][3]int
+	for {
+		side, i, j, ok := v.Next()
+		if !ok {
+			break
+		}
+		first = append(first, [3]int{int(side), i, j})
+	}
+
+	v.Reset()
+	var second [][3]int
+	for {
+		side, i, j, ok := v.Next()
+		if !ok {
+			break
+		}
+		second = append(second, [3]int{int(side), i, j})
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("after Reset, Next() steps = %v, want %v", second, first)
+	}
+}